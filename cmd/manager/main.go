@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -30,7 +31,6 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -40,15 +40,17 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 const (
-	// TODO(tflannag): Should this be configurable?
-	defaultLeaderElectionConfigMapName = "marketplace-operator-lock"
-	defaultRetryPeriod                 = 30 * time.Second
-	defaultRenewDeadline               = 60 * time.Second
-	defaultLeaseDuration               = 90 * time.Second
+	defaultLeaderElectionLockName = "marketplace-operator-lock"
+	defaultLeaderElectionLock     = resourcelock.LeasesResourceLock
+	defaultRetryPeriod            = 30 * time.Second
+	defaultRenewDeadline          = 60 * time.Second
+	defaultLeaseDuration          = 90 * time.Second
+	defaultCatsrcDriftInterval    = 10 * time.Minute
 )
 
 func printVersion() {
@@ -80,6 +82,14 @@ func main() {
 		tlsKeyPath              string
 		tlsCertPath             string
 		leaderElectionNamespace string
+		leaderElectionLockName  string
+		leaderElectionLockType  string
+		leaseDuration           time.Duration
+		renewDeadline           time.Duration
+		retryPeriod             time.Duration
+		healthAddr              string
+		metricsAddr             string
+		catsrcDriftInterval     time.Duration
 		version                 bool
 	)
 	flag.StringVar(&clusterOperatorName, "clusterOperatorName", "", "configures the name of the OpenShift ClusterOperator that should reflect this operator's status, or the empty string to disable ClusterOperator updates")
@@ -88,6 +98,14 @@ func main() {
 	flag.StringVar(&tlsKeyPath, "tls-key", "", "Path to use for private key (requires tls-cert)")
 	flag.StringVar(&tlsCertPath, "tls-cert", "", "Path to use for certificate (requires tls-key)")
 	flag.StringVar(&leaderElectionNamespace, "leader-namespace", "openshift-marketplace", "configures the namespace that will contain the leader election lock")
+	flag.StringVar(&leaderElectionLockName, "leader-election-lock-name", defaultLeaderElectionLockName, "configures the name of the leader election lock resource")
+	flag.StringVar(&leaderElectionLockType, "leader-election-resource-lock", defaultLeaderElectionLock, "configures the resource lock type used for leader election; one of 'leases', 'configmaps', or 'configmapsleases' (use 'configmapsleases' to roll an existing ConfigMap lock forward to Leases)")
+	flag.DurationVar(&leaseDuration, "leader-election-lease-duration", defaultLeaseDuration, "configures the duration that non-leader candidates will wait to force acquire leadership")
+	flag.DurationVar(&renewDeadline, "leader-election-renew-deadline", defaultRenewDeadline, "configures the duration that the acting leader will retry refreshing leadership before giving up")
+	flag.DurationVar(&retryPeriod, "leader-election-retry-period", defaultRetryPeriod, "configures the duration leader election clients should wait between tries of actions")
+	flag.StringVar(&healthAddr, "health-addr", ":8080", "configures the address the liveness health check endpoint binds to")
+	flag.StringVar(&metricsAddr, "metrics-addr", ":8686", "configures the address the controller-runtime metrics endpoint binds to")
+	flag.DurationVar(&catsrcDriftInterval, "catalogsource-drift-interval", defaultCatsrcDriftInterval, "configures how often the operator sweeps default CatalogSources for drift, independent of informer events")
 	flag.Parse()
 
 	logger := logrus.New()
@@ -130,24 +148,63 @@ func main() {
 	// watch for CatalogSources in targetNamespaces being deleted and recreate
 	// them.
 	//
-	// Note(tflannag): Setting the `MetricsBindAddress` to `0` here disables the
-	// metrics listener from controller-runtime. Previously, this was disabled by
-	// default in <v0.2.0, but it's now enabled by default and the default port
-	// conflicts with the same port we bind for the health checks.
+	// Note(tflannag): MetricsBindAddress is a second, plain-HTTP listener bound
+	// to its own --metrics-addr, separate from the TLS-wrapped endpoint that
+	// metrics.ServePrometheus already set up above. The two expose independent
+	// Prometheus registries -- this one is controller-runtime's own
+	// metrics.Registry, which is where catsrcDriftedGauge and friends are
+	// registered -- so scraping both endpoints is required to see all of the
+	// operator's metrics. --metrics-addr defaults to a port distinct from the
+	// one metrics.ServePrometheus binds to.
 	mgr, err := manager.New(cfg, manager.Options{
 		Namespace:          "",
-		MetricsBindAddress: "0",
+		MetricsBindAddress: metricsAddr,
 		Scheme:             scheme,
 	})
 	if err != nil {
 		logger.Fatal(err)
 	}
 
+	client, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		logger.Fatal(fmt.Errorf("failed to initialize the kubernetes clientset: %v", err))
+	}
+
+	// isLeader tracks whether this instance currently holds the leader-election
+	// lock. It's read by the readyz handler below and flipped from the
+	// OnStartedLeading/OnStoppedLeading callbacks.
+	//
+	// The health/readyz endpoints below are served by a plain net/http server
+	// that runs unconditionally, independent of mgr.Start(). This manager's
+	// Start() is only ever invoked from inside OnStartedLeading, gated behind
+	// the external leaderelection.RunOrDie below -- so a manager-owned
+	// HealthProbeBindAddress listener would never come up on standby replicas,
+	// and kubelet's liveness/readiness probes against them would simply hang
+	// until they timed out and got restarted. Running this server up front,
+	// before leader election even starts, keeps kubelet routing traffic away
+	// from (and not restarting) standby pods during the election window.
+	var isLeader int32
 	logger.Info("setting up health checks")
-	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-	go http.ListenAndServe(":8080", nil)
+	http.Handle("/healthz", &healthz.Handler{Checks: map[string]healthz.Checker{
+		"ping": healthz.Ping,
+	}})
+	http.Handle("/readyz", &healthz.Handler{Checks: map[string]healthz.Checker{
+		"apiserver": func(_ *http.Request) error {
+			_, err := client.Discovery().ServerVersion()
+			return err
+		},
+		"leader-election": func(_ *http.Request) error {
+			if atomic.LoadInt32(&isLeader) == 0 {
+				return fmt.Errorf("standing by: not currently the leader")
+			}
+			return nil
+		},
+	}})
+	go func() {
+		if err := http.ListenAndServe(healthAddr, nil); err != nil {
+			logger.WithError(err).Error("health check server stopped")
+		}
+	}()
 
 	ctx := signals.Context()
 	stopCh := ctx.Done()
@@ -171,7 +228,9 @@ func main() {
 		}
 
 		logger.Info("setting up controllers")
-		if err := controller.AddToManager(mgr, options.ControllerOptions{}); err != nil {
+		if err := controller.AddToManager(mgr, options.ControllerOptions{
+			CatsrcDriftInterval: catsrcDriftInterval,
+		}); err != nil {
 			logger.Fatal(err)
 		}
 
@@ -190,11 +249,6 @@ func main() {
 		<-statusReportingDoneCh
 	}
 
-	client, err := kubernetes.NewForConfig(mgr.GetConfig())
-	if err != nil {
-		logger.Fatal(fmt.Errorf("failed to initialize the kubernetes clientset: %v", err))
-	}
-
 	id := os.Getenv("POD_NAME")
 	if id == "" {
 		logger.Info("failed to determine $POD_NAME falling back to hostname")
@@ -204,28 +258,26 @@ func main() {
 		}
 	}
 
-	rl := &resourcelock.ConfigMapLock{
-		Client: client.CoreV1(),
-		LockConfig: resourcelock.ResourceLockConfig{
-			Identity: id,
-		},
-		ConfigMapMeta: v1.ObjectMeta{
-			Name:      defaultLeaderElectionConfigMapName,
-			Namespace: leaderElectionNamespace,
-		},
+	rl, err := resourcelock.New(leaderElectionLockType, leaderElectionNamespace, leaderElectionLockName, client.CoreV1(), client.CoordinationV1(), resourcelock.ResourceLockConfig{
+		Identity: id,
+	})
+	if err != nil {
+		logger.Fatalf("failed to create %s resource lock: %v", leaderElectionLockType, err)
 	}
 	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 		Lock:            rl,
 		ReleaseOnCancel: true,
-		LeaseDuration:   defaultLeaseDuration,
-		RenewDeadline:   defaultRenewDeadline,
-		RetryPeriod:     defaultRetryPeriod,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				logger.Infof("became leader: %s", id)
+				atomic.StoreInt32(&isLeader, 1)
 				run(leaderCtx)
 			},
 			OnStoppedLeading: func() {
+				atomic.StoreInt32(&isLeader, 0)
 				logger.Warnf("leader election lost for %s identity", id)
 			},
 			OnNewLeader: func(identity string) {
@@ -2,14 +2,20 @@ package catalogsource
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	apiconfigv1 "github.com/openshift/api/config/v1"
+	configv1 "github.com/operator-framework/operator-marketplace/pkg/apis/config/v1"
 	olm "github.com/operator-framework/operator-marketplace/pkg/apis/olm/v1alpha1"
 	"github.com/operator-framework/operator-marketplace/pkg/controller/options"
 	"github.com/operator-framework/operator-marketplace/pkg/defaults"
 	"github.com/operator-framework/operator-marketplace/pkg/operatorhub"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -20,20 +26,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+const (
+	// ReasonDefaultRestored is emitted when a default CatalogSource is recreated after being deleted.
+	ReasonDefaultRestored = "DefaultRestored"
+	// ReasonSpecReverted is emitted when a drifted default CatalogSource is deleted so it can be recreated with the desired spec.
+	ReasonSpecReverted = "SpecReverted"
+	// ReasonEnforcementFailed is emitted when the controller fails to restore a default CatalogSource to its desired state.
+	ReasonEnforcementFailed = "EnforcementFailed"
+)
+
 // Add creates a new CatalogSource Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
-func Add(mgr manager.Manager, _ options.ControllerOptions) error {
-	return add(mgr, newReconciler(mgr))
+func Add(mgr manager.Manager, opts options.ControllerOptions) error {
+	return add(mgr, newReconciler(mgr), opts)
 }
 
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	client := mgr.GetClient()
 	return &ReconcileCatalogSource{
-		client: client,
+		client:   client,
+		recorder: mgr.GetEventRecorderFor("catalogsource-controller"),
 	}
 }
 
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, opts options.ControllerOptions) error {
 
 	c, err := controller.New("catalogsource-controller", mgr, controller.Options{Reconciler: r})
 	if err != nil {
@@ -75,6 +91,18 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// driftEvents feeds the scheduler's periodic drift-reconciliation passes
+	// through this same controller and predicate, via a source.Channel, so they
+	// go through the workqueue instead of calling the Reconciler directly.
+	driftEvents := make(chan event.GenericEvent)
+	if err := c.Watch(&source.Channel{Source: driftEvents}, &handler.EnqueueRequestForObject{}, pred); err != nil {
+		return err
+	}
+
+	if err := mgr.Add(newDriftScheduler(mgr, driftEvents, opts)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -86,20 +114,38 @@ type ReconcileCatalogSource struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client client.Client
+
+	// recorder surfaces default-enforcement actions as Kubernetes Events. If the
+	// operator lacks RBAC to create Events, the underlying EventBroadcaster logs
+	// a warning and drops the Event instead of failing the reconcile.
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileCatalogSource) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	log.Infof("Reconciling default CatalogSource %s", request.Name)
 
+	start := time.Now()
+	defer func() {
+		catsrcReconcileDuration.WithLabelValues(request.Name).Observe(time.Since(start).Seconds())
+	}()
+
 	_, defaultCatalogsources := defaults.GetGlobalDefinitions()
 	defaultCatsrcDef := defaultCatalogsources[request.Name]
+
 	// Fetch the CatalogSource instance
 	instance := &olm.CatalogSource{}
 	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if !operatorhub.GetSingleton().Get()[defaultCatsrcDef.Name] {
-				createNewCatsrcInstance(r.client, defaultCatsrcDef)
+				if err := createNewCatsrcInstance(r.client, defaultCatsrcDef); err != nil {
+					catsrcDriftedGauge.WithLabelValues(request.Name).Set(1)
+					r.recordEnforcementEvent(&defaultCatsrcDef, r.getOperatorHubCluster(), corev1.EventTypeWarning, ReasonEnforcementFailed, fmt.Sprintf("failed to recreate missing default CatalogSource: %s", err.Error()))
+				} else {
+					catsrcRecreatedTotal.WithLabelValues(request.Name).Inc()
+					catsrcDriftedGauge.WithLabelValues(request.Name).Set(0)
+					r.recordEnforcementEvent(&defaultCatsrcDef, r.getOperatorHubCluster(), corev1.EventTypeNormal, ReasonDefaultRestored, "recreated missing default CatalogSource")
+				}
 			}
 			return reconcile.Result{}, nil
 		}
@@ -112,14 +158,45 @@ func (r *ReconcileCatalogSource) Reconcile(request reconcile.Request) (reconcile
 	}
 
 	if !defaults.AreCatsrcSpecsEqual(&defaultCatsrcDef.Spec, &instance.Spec) {
+		catsrcDriftedGauge.WithLabelValues(request.Name).Set(1)
+		diff := fmt.Sprintf("desired spec: %+v, observed spec: %+v", defaultCatsrcDef.Spec, instance.Spec)
 		if err := r.client.Delete(context.TODO(), instance); err != nil {
 			log.Warnf("Could not set default CatalogSource %s's spec back to desired default state. Error in deleting updated CatalogSource: %s", defaultCatsrcDef.GetName(), err.Error())
+			r.recordEnforcementEvent(instance, r.getOperatorHubCluster(), corev1.EventTypeWarning, ReasonEnforcementFailed, fmt.Sprintf("failed to revert drifted spec: %s (%s)", err.Error(), diff))
+			return reconcile.Result{Requeue: true, RequeueAfter: time.Second * 5}, nil
 		}
+		catsrcSpecRevertedTotal.WithLabelValues(request.Name).Inc()
+		r.recordEnforcementEvent(instance, r.getOperatorHubCluster(), corev1.EventTypeNormal, ReasonSpecReverted, fmt.Sprintf("deleted drifted default CatalogSource so it can be recreated with the desired spec (%s)", diff))
 		return reconcile.Result{Requeue: true, RequeueAfter: time.Second * 5}, nil
 	}
+	catsrcDriftedGauge.WithLabelValues(request.Name).Set(0)
 	return reconcile.Result{}, nil
 }
 
+// getOperatorHubCluster returns the cluster-wide OperatorHub config resource, if the
+// config API is available and the resource exists, so enforcement events can also be
+// scoped to it. A nil return means enforcement events are only recorded against the
+// involved CatalogSource.
+func (r *ReconcileCatalogSource) getOperatorHubCluster() *apiconfigv1.OperatorHub {
+	if !configv1.IsAPIAvailable() {
+		return nil
+	}
+	hubCluster := &apiconfigv1.OperatorHub{}
+	if err := r.client.Get(context.TODO(), client.ObjectKey{Name: operatorhub.DefaultName}, hubCluster); err != nil {
+		return nil
+	}
+	return hubCluster
+}
+
+// recordEnforcementEvent records a default-enforcement Event against the involved
+// CatalogSource and, when available, the cluster-wide OperatorHub config.
+func (r *ReconcileCatalogSource) recordEnforcementEvent(catsrc runtime.Object, hubCluster *apiconfigv1.OperatorHub, eventType, reason, message string) {
+	r.recorder.Event(catsrc, eventType, reason, message)
+	if hubCluster != nil {
+		r.recorder.Event(hubCluster, eventType, reason, message)
+	}
+}
+
 func createNewCatsrcInstance(client client.Client, catsrc olm.CatalogSource) error {
 	err := client.Create(context.TODO(), &catsrc)
 	if err != nil {
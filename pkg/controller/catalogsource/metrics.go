@@ -0,0 +1,45 @@
+package catalogsource
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// catsrcDriftedGauge reports whether a default CatalogSource is currently
+	// drifted (1) from, or enforced to (0), its desired spec, by name.
+	catsrcDriftedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "catalogsource_default_drifted",
+		Help: "Whether a default CatalogSource is currently drifted (1) from or enforced to (0) its desired spec, by CatalogSource name.",
+	}, []string{"name"})
+
+	// catsrcRecreatedTotal counts recreations of a default CatalogSource after it
+	// was deleted, by name.
+	catsrcRecreatedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "catalogsource_default_recreated_total",
+		Help: "Total number of times a default CatalogSource was recreated after being deleted, by CatalogSource name.",
+	}, []string{"name"})
+
+	// catsrcSpecRevertedTotal counts spec reverts of a default CatalogSource, by
+	// name.
+	catsrcSpecRevertedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "catalogsource_default_spec_reverted_total",
+		Help: "Total number of times a default CatalogSource's spec was reverted back to its desired state, by CatalogSource name.",
+	}, []string{"name"})
+
+	// catsrcReconcileDuration tracks reconcile latency for each default
+	// CatalogSource name.
+	catsrcReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "catalogsource_default_reconcile_duration_seconds",
+		Help: "Latency of reconciling a default CatalogSource, by CatalogSource name.",
+	}, []string{"name"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		catsrcDriftedGauge,
+		catsrcRecreatedTotal,
+		catsrcSpecRevertedTotal,
+		catsrcReconcileDuration,
+	)
+}
@@ -0,0 +1,109 @@
+package catalogsource
+
+import (
+	"time"
+
+	olm "github.com/operator-framework/operator-marketplace/pkg/apis/olm/v1alpha1"
+	"github.com/operator-framework/operator-marketplace/pkg/controller/options"
+	"github.com/operator-framework/operator-marketplace/pkg/defaults"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// defaultDriftInterval is how often the drift scheduler enqueues a
+// reconcile.Request for every default CatalogSource when
+// options.ControllerOptions.CatsrcDriftInterval is unset.
+const defaultDriftInterval = 10 * time.Minute
+
+// blank assignment to verify that driftScheduler implements manager.Runnable
+var _ manager.Runnable = &driftScheduler{}
+
+// electionAwareManager is the subset of manager.Manager that driftScheduler
+// depends on, so tests can drive it with a fake instead of a full
+// controller-runtime Manager.
+type electionAwareManager interface {
+	Elected() <-chan struct{}
+}
+
+// driftScheduler is a manager.Runnable that periodically enqueues a
+// reconcile.Request for every name in defaults.GetGlobalDefinitions(). It exists
+// because the controller's predicate only reacts to informer events on default
+// CatalogSources, and drift can otherwise persist indefinitely if an update is
+// missed entirely -- for example during controller downtime, a cache resync gap,
+// or a DeleteStateUnknown tombstone, which the predicate explicitly ignores.
+//
+// Requests are delivered through events, which the controller watches with a
+// source.Channel, rather than by calling the Reconciler directly. That way a
+// drift-enforcement pass that spans more than one reconcile -- the controller
+// first deletes a drifted CatalogSource and returns Requeue: true so the
+// recreate happens on the next pass -- still goes through the controller's
+// workqueue and its normal Requeue/RequeueAfter handling, instead of having
+// that signal silently dropped.
+type driftScheduler struct {
+	mgr      electionAwareManager
+	events   chan<- event.GenericEvent
+	interval time.Duration
+
+	// defaultCatalogsources returns the current set of default CatalogSources to
+	// sweep. It defaults to defaults.GetGlobalDefinitions, overridable in tests.
+	defaultCatalogsources func() map[string]olm.CatalogSource
+}
+
+// newDriftScheduler returns a driftScheduler that runs every opts.CatsrcDriftInterval,
+// falling back to defaultDriftInterval when it is unset, delivering its requests onto events.
+func newDriftScheduler(mgr electionAwareManager, events chan<- event.GenericEvent, opts options.ControllerOptions) *driftScheduler {
+	interval := opts.CatsrcDriftInterval
+	if interval <= 0 {
+		interval = defaultDriftInterval
+	}
+	return &driftScheduler{
+		mgr:      mgr,
+		events:   events,
+		interval: interval,
+		defaultCatalogsources: func() map[string]olm.CatalogSource {
+			_, defaultCatalogsources := defaults.GetGlobalDefinitions()
+			return defaultCatalogsources
+		},
+	}
+}
+
+// Start blocks until the manager has either elected this instance as leader or
+// stop is closed, then enqueues a drift-reconciliation pass on every interval tick
+// until stop is closed. Followers never reach the ticking loop, so only the
+// leader drives drift reconciliation.
+func (s *driftScheduler) Start(stop <-chan struct{}) error {
+	select {
+	case <-s.mgr.Elected():
+	case <-stop:
+		return nil
+	}
+
+	log.Infof("starting default CatalogSource drift scheduler with a %s interval", s.interval)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.enqueueAll(stop)
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// enqueueAll sends a GenericEvent for every default CatalogSource onto the
+// scheduler's event channel so the controller's source.Channel watch puts a
+// reconcile.Request for each one on the workqueue.
+func (s *driftScheduler) enqueueAll(stop <-chan struct{}) {
+	for name, catsrc := range s.defaultCatalogsources() {
+		catsrc := catsrc
+		select {
+		case s.events <- event.GenericEvent{Meta: &catsrc, Object: &catsrc}:
+		case <-stop:
+			return
+		}
+		log.Debugf("drift scheduler enqueued a reconcile.Request for default CatalogSource %s", name)
+	}
+}
@@ -0,0 +1,77 @@
+package catalogsource
+
+import (
+	"testing"
+	"time"
+
+	olm "github.com/operator-framework/operator-marketplace/pkg/apis/olm/v1alpha1"
+	"github.com/operator-framework/operator-marketplace/pkg/controller/options"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// alreadyElectedManager is an electionAwareManager backed by the given elected
+// channel. newAlreadyElectedManager returns one whose channel is already closed,
+// so driftScheduler.Start proceeds straight to its ticking loop; built with an
+// open channel it stands in for a follower that is never elected.
+type alreadyElectedManager struct {
+	elected chan struct{}
+}
+
+func newAlreadyElectedManager() *alreadyElectedManager {
+	elected := make(chan struct{})
+	close(elected)
+	return &alreadyElectedManager{elected: elected}
+}
+
+func (m *alreadyElectedManager) Elected() <-chan struct{} {
+	return m.elected
+}
+
+func TestDriftSchedulerEnqueuesThroughWorkqueue(t *testing.T) {
+	events := make(chan event.GenericEvent, 1)
+	s := newDriftScheduler(newAlreadyElectedManager(), events, options.ControllerOptions{
+		CatsrcDriftInterval: 10 * time.Millisecond,
+	})
+	s.defaultCatalogsources = func() map[string]olm.CatalogSource {
+		return map[string]olm.CatalogSource{
+			"my-default-catsrc": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-default-catsrc",
+					Namespace: "openshift-marketplace",
+				},
+			},
+		}
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go s.Start(stop)
+
+	select {
+	case evt := <-events:
+		if evt.Meta.GetName() != "my-default-catsrc" || evt.Meta.GetNamespace() != "openshift-marketplace" {
+			t.Fatalf("expected an event for openshift-marketplace/my-default-catsrc, got %s/%s", evt.Meta.GetNamespace(), evt.Meta.GetName())
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the drift scheduler to enqueue an event")
+	}
+}
+
+func TestDriftSchedulerStopsWithoutBeingElected(t *testing.T) {
+	stop := make(chan struct{})
+	s := newDriftScheduler(&alreadyElectedManager{elected: make(chan struct{})}, make(chan event.GenericEvent), options.ControllerOptions{})
+
+	done := make(chan error, 1)
+	go func() { done <- s.Start(stop) }()
+	close(stop)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected Start to return nil, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for Start to return after stop was closed before election")
+	}
+}
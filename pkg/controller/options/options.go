@@ -0,0 +1,14 @@
+package options
+
+import "time"
+
+// ControllerOptions holds configuration that adjusts the runtime behavior of the
+// controllers registered via AddToManager. It exists so tests can drive
+// otherwise time- or environment-dependent behavior deterministically, without
+// adding a command-line flag for every control loop.
+type ControllerOptions struct {
+	// CatsrcDriftInterval configures how often the CatalogSource controller's
+	// drift scheduler re-enqueues a reconcile.Request for every default
+	// CatalogSource. The zero value selects the scheduler's built-in default.
+	CatsrcDriftInterval time.Duration
+}